@@ -0,0 +1,118 @@
+package govault
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Peek returns the value for key without promoting it to MRU (under
+// PolicyLRU) or setting its visited bit (under PolicySIEVE), so admission
+// checks don't pollute the eviction order. An expired entry is treated as a
+// miss but, unlike Get, is left in place for the janitor or a future Get to
+// remove.
+func (c *Cache[Key, Value]) Peek(key Key) (Value, bool) {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	elem, exists := c.Store[key]
+	if !exists {
+		var zero Value
+		return zero, false
+	}
+
+	ent := elem.Value.(*entry[Key, Value])
+	if !ent.expiresAt.IsZero() && time.Now().After(ent.expiresAt) {
+		var zero Value
+		return zero, false
+	}
+
+	return ent.value, true
+}
+
+// Contains reports whether key is present and unexpired, without affecting
+// its eviction order.
+func (c *Cache[Key, Value]) Contains(key Key) bool {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	elem, exists := c.Store[key]
+	if !exists {
+		return false
+	}
+
+	ent := elem.Value.(*entry[Key, Value])
+	return ent.expiresAt.IsZero() || time.Now().Before(ent.expiresAt)
+}
+
+// Len returns the number of entries currently in the cache, including any
+// not-yet-swept expired entries.
+func (c *Cache[Key, Value]) Len() int {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	return len(c.Store)
+}
+
+// Size returns the current memory usage in bytes.
+func (c *Cache[Key, Value]) Size() int64 {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	return c.CurrentSize
+}
+
+// Keys returns the cache's keys in eviction-list order, from the list's back
+// to the entry most recently pushed to its front. Under PolicyLRU that's
+// least-to-most recently used, starting with the entry that would be evicted
+// next; under PolicySIEVE the list isn't reordered on access, so it's
+// oldest-to-newest inserted instead, and the next entry to be evicted is
+// wherever the SIEVE hand finds an unvisited entry.
+func (c *Cache[Key, Value]) Keys() []Key {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	keys := make([]Key, 0, len(c.Store))
+	for elem := c.EvictList.Back(); elem != nil; elem = elem.Prev() {
+		keys = append(keys, elem.Value.(*entry[Key, Value]).key)
+	}
+	return keys
+}
+
+// Range calls f for each entry in the cache in eviction-list order, from the
+// front to the back. Under PolicyLRU that's most-to-least recently used, so
+// the entry least likely to be evicted next comes first; under PolicySIEVE
+// it's newest-to-oldest inserted, since the list isn't reordered on access. Range
+// stops early if f returns false. f must not call back into the cache, since
+// Range holds c.Mutex for its duration.
+func (c *Cache[Key, Value]) Range(f func(Key, Value) bool) {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	for elem := c.EvictList.Front(); elem != nil; elem = elem.Next() {
+		ent := elem.Value.(*entry[Key, Value])
+		if !f(ent.key, ent.value) {
+			return
+		}
+	}
+}
+
+// Stats reports cumulative counters for a Cache's Get/Set activity.
+type Stats struct {
+	Hits              uint64
+	Misses            uint64
+	Insertions        uint64
+	CapacityEvictions uint64
+	TTLEvictions      uint64
+}
+
+// Stats returns a snapshot of the cache's hit/miss/eviction/insertion
+// counters. It's maintained with atomic counters and doesn't take c.Mutex.
+func (c *Cache[Key, Value]) Stats() Stats {
+	return Stats{
+		Hits:              atomic.LoadUint64(&c.statsHits),
+		Misses:            atomic.LoadUint64(&c.statsMisses),
+		Insertions:        atomic.LoadUint64(&c.statsInsertions),
+		CapacityEvictions: atomic.LoadUint64(&c.statsCapacityEvictions),
+		TTLEvictions:      atomic.LoadUint64(&c.statsTTLEvictions),
+	}
+}
@@ -2,6 +2,10 @@ package govault_test
 
 import (
 	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
 
 	"github.com/drmorax/govault"
 )
@@ -61,3 +65,302 @@ func ExampleCache_Delete() {
 	//key-1: [1 2 3 4 5] true
 	//key-1: [] false
 }
+
+// ExampleNewWithPolicy_sieveRecoversAfterGetOnSoleSurvivor guards against a
+// regression where the SIEVE hand, on reaching a single surviving entry with
+// its visited bit set, would clear the bit and exit without evicting,
+// leaving the cache permanently over MaxSize.
+func ExampleNewWithPolicy_sieveRecoversAfterGetOnSoleSurvivor() {
+	cache := govault.NewWithPolicy[string, []byte](1, govault.PolicySIEVE)
+
+	cache.Set("a", make([]byte, 600*1024))
+	cache.Get("a") // sets the visited bit on the only entry in the cache
+	cache.Set("a", make([]byte, 2*1024*1024))
+
+	fmt.Println(cache.CurrentSize <= cache.MaxSize)
+	// Output: true
+}
+
+// TestShardedCache_ConcurrentAccess writes distinct keys from many goroutines
+// at once, which exercises Set routing to different shards' independent
+// mutexes concurrently, then reads every key back from a single goroutine to
+// check it landed intact; run with -race to catch any shared state that isn't
+// actually shard-local.
+func TestShardedCache_ConcurrentAccess(t *testing.T) {
+	cache := govault.NewSharded[string, int](8, 4)
+
+	const n = 200
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := "key-" + strconv.Itoa(i)
+			cache.Set(key, i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		key := "key-" + strconv.Itoa(i)
+		value, found := cache.Get(key)
+		if !found {
+			t.Errorf("key %q missing after concurrent Set", key)
+			continue
+		}
+		if value != i {
+			t.Errorf("key %q = %d, want %d", key, value, i)
+		}
+	}
+
+	if got := cache.Len(); got != n {
+		t.Errorf("Len() = %d, want %d", got, n)
+	}
+}
+
+// TestCache_TTLExpiryAndJanitor checks that an entry set with SetWithTTL is
+// proactively swept by the background janitor (not just on a later Get) and
+// reported to OnEvict with ReasonTTL exactly once.
+func TestCache_TTLExpiryAndJanitor(t *testing.T) {
+	var mu sync.Mutex
+	var reasons []govault.EvictReason
+
+	cache := govault.NewWithOptions[string, string](1, govault.Options[string, string]{
+		JanitorInterval: 10 * time.Millisecond,
+		OnEvict: func(_ string, _ string, reason govault.EvictReason) {
+			mu.Lock()
+			reasons = append(reasons, reason)
+			mu.Unlock()
+		},
+	})
+	defer cache.Close()
+
+	cache.SetWithTTL("a", "b", 5*time.Millisecond)
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		n := len(reasons)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(reasons) != 1 {
+		t.Fatalf("expected exactly one eviction callback, got %d", len(reasons))
+	}
+	if reasons[0] != govault.ReasonTTL {
+		t.Fatalf("reason = %v, want ReasonTTL", reasons[0])
+	}
+
+	if got := cache.Len(); got != 0 {
+		t.Fatalf("Len() after janitor sweep = %d, want 0", got)
+	}
+}
+
+// fixedSizer is a Value type with a hand-picked CacheSize, used to check
+// that calculateEntrySize defers to Sizer instead of reflecting over the
+// struct's fields.
+type fixedSizer struct {
+	n int
+}
+
+func (f fixedSizer) CacheSize() int64 { return int64(f.n) }
+
+// TestCache_SizerInterfaceUsedForCustomTypes checks that a Value implementing
+// Sizer drives its own entry size: resizing the same key's CacheSize by a
+// given delta should move Cache.Size() by exactly that delta, regardless of
+// the struct's actual in-memory layout.
+func TestCache_SizerInterfaceUsedForCustomTypes(t *testing.T) {
+	cache := govault.New[string, fixedSizer](1)
+
+	cache.Set("k", fixedSizer{n: 100})
+	before := cache.Size()
+
+	cache.Set("k", fixedSizer{n: 1000})
+	after := cache.Size()
+
+	if delta := after - before; delta != 900 {
+		t.Fatalf("Size() delta = %d, want 900 (CacheSize should be used directly)", delta)
+	}
+}
+
+// TestCache_NewWithSizerBypassesDefaultSizing checks that a Cache built with
+// NewWithSizer uses the supplied sizer exactly, without adding any key/value
+// overhead from the default estimator.
+func TestCache_NewWithSizerBypassesDefaultSizing(t *testing.T) {
+	cache := govault.NewWithSizer[string, []byte](1, func(_ string, v []byte) int64 {
+		return int64(len(v))
+	})
+
+	cache.Set("a", make([]byte, 100))
+	if got := cache.Size(); got != 100 {
+		t.Fatalf("Size() = %d, want 100", got)
+	}
+
+	cache.Set("a", make([]byte, 300))
+	if got := cache.Size(); got != 300 {
+		t.Fatalf("Size() = %d, want 300", got)
+	}
+}
+
+// nonFastPathStruct implements neither Sizer nor any of the fastSize cases,
+// so it can only be sized via reflection.
+type nonFastPathStruct struct {
+	payload [1000]byte
+}
+
+// TestCache_ReflectionFallbackDisabledPanics checks that, with
+// ReflectionFallbackEnabled off, sizing a type with no Sizer and no fast
+// path panics instead of silently reporting the interface header's size.
+func TestCache_ReflectionFallbackDisabledPanics(t *testing.T) {
+	govault.ReflectionFallbackEnabled = false
+	defer func() { govault.ReflectionFallbackEnabled = true }()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Set to panic with ReflectionFallbackEnabled = false")
+		}
+	}()
+
+	cache := govault.New[string, nonFastPathStruct](1)
+	cache.Set("a", nonFastPathStruct{})
+}
+
+// TestCache_PeekDoesNotPromote checks that Peek, unlike Get, leaves the LRU
+// order untouched.
+func TestCache_PeekDoesNotPromote(t *testing.T) {
+	cache := govault.New[string, int](1)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	if _, found := cache.Peek("a"); !found {
+		t.Fatal("Peek(\"a\") = not found, want found")
+	}
+
+	if keys := cache.Keys(); len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("Keys() after Peek = %v, want [a b] (Peek must not promote \"a\")", keys)
+	}
+}
+
+// TestCache_PeekDoesNotSetVisitedBit checks that Peek, unlike Get, leaves a
+// SIEVE entry's visited bit clear. A 1-entry-sized cache makes eviction of
+// "a" depend entirely on whether its visited bit was set.
+func TestCache_PeekDoesNotSetVisitedBit(t *testing.T) {
+	cache := govault.NewWithPolicy[string, []byte](1, govault.PolicySIEVE)
+
+	cache.Set("a", make([]byte, 700*1024))
+	if _, found := cache.Peek("a"); !found {
+		t.Fatal("Peek(\"a\") = not found, want found")
+	}
+
+	// Forces an eviction pass; "a" should lose immediately since Peek must
+	// not have set its visited bit.
+	cache.Set("b", make([]byte, 700*1024))
+
+	if _, found := cache.Peek("a"); found {
+		t.Fatal("\"a\" survived eviction: Peek must have set its visited bit")
+	}
+	if _, found := cache.Peek("b"); !found {
+		t.Fatal("\"b\" should be present after \"a\" is evicted")
+	}
+}
+
+// TestCache_ContainsDoesNotPromote checks that Contains, like Peek, leaves
+// the LRU order untouched.
+func TestCache_ContainsDoesNotPromote(t *testing.T) {
+	cache := govault.New[string, int](1)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	if !cache.Contains("a") {
+		t.Fatal("Contains(\"a\") = false, want true")
+	}
+
+	if keys := cache.Keys(); len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("Keys() after Contains = %v, want [a b] (Contains must not promote \"a\")", keys)
+	}
+}
+
+// TestCache_RangeStopsEarly checks that returning false from Range's
+// callback stops iteration before visiting every entry.
+func TestCache_RangeStopsEarly(t *testing.T) {
+	cache := govault.New[string, int](1)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	var visited []string
+	cache.Range(func(k string, _ int) bool {
+		visited = append(visited, k)
+		return len(visited) < 2
+	})
+
+	if len(visited) != 2 {
+		t.Fatalf("Range visited %d entries, want 2 (should stop once f returns false)", len(visited))
+	}
+}
+
+// TestCache_StatsCounters checks that Stats accumulates hits, misses,
+// insertions, and capacity evictions correctly.
+func TestCache_StatsCounters(t *testing.T) {
+	cache := govault.New[string, []byte](1)
+
+	cache.Set("a", make([]byte, 10)) // insertion
+	cache.Get("a")                   // hit
+	cache.Get("missing")             // miss
+
+	stats := cache.Stats()
+	if stats.Insertions != 1 {
+		t.Errorf("Insertions = %d, want 1", stats.Insertions)
+	}
+	if stats.Hits != 1 {
+		t.Errorf("Hits = %d, want 1", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Errorf("Misses = %d, want 1", stats.Misses)
+	}
+
+	// "b" alone fits under MaxSize, but together with "a" it doesn't,
+	// forcing exactly one capacity eviction (of "a").
+	cache.Set("b", make([]byte, cache.MaxSize-64))
+
+	stats = cache.Stats()
+	if stats.CapacityEvictions != 1 {
+		t.Errorf("CapacityEvictions = %d, want 1", stats.CapacityEvictions)
+	}
+	if stats.Insertions != 2 {
+		t.Errorf("Insertions = %d, want 2", stats.Insertions)
+	}
+	if _, found := cache.Peek("b"); !found {
+		t.Error("\"b\" should survive since it fits alone under MaxSize")
+	}
+}
+
+// TestCache_KeysOrderDiffersByPolicy checks that Keys reflects actual
+// eviction-list order rather than an LRU-specific assumption: under
+// PolicyLRU a Get reorders the list, but under PolicySIEVE it doesn't.
+func TestCache_KeysOrderDiffersByPolicy(t *testing.T) {
+	lru := govault.New[string, int](1)
+	lru.Set("a", 1)
+	lru.Set("b", 2)
+	lru.Get("a") // promotes "a" to most-recently-used
+
+	if got := lru.Keys(); len(got) != 2 || got[0] != "b" || got[1] != "a" {
+		t.Fatalf("PolicyLRU Keys() = %v, want [b a]", got)
+	}
+
+	sieve := govault.NewWithPolicy[string, int](1, govault.PolicySIEVE)
+	sieve.Set("a", 1)
+	sieve.Set("b", 2)
+	sieve.Get("a") // only sets the visited bit; insertion order is unchanged
+
+	if got := sieve.Keys(); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("PolicySIEVE Keys() = %v, want [a b] (insertion order, unaffected by Get)", got)
+	}
+}
@@ -0,0 +1,77 @@
+package govault
+
+import (
+	"time"
+	"unsafe"
+)
+
+// Sizer lets a Value type report its own in-cache byte cost, bypassing both
+// the built-in fast paths and the reflection-based estimator. Types with
+// shared backing arrays, interfaces, or cycles should implement this, since
+// calculateSize's recursive walk gives wrong answers for them.
+type Sizer interface {
+	CacheSize() int64
+}
+
+// ReflectionFallbackEnabled controls whether sizeOfValue falls back to the
+// slow, allocation-heavy reflection-based estimate (calculateSize) for types
+// that aren't a Sizer and don't match a fastSize case. It defaults to true
+// for backward compatibility; a future major version will default this to
+// false, making Sizer or NewWithSizer required for anything but the built-in
+// fast-path types.
+var ReflectionFallbackEnabled = true
+
+// fastSize reports the in-cache byte cost of v for a handful of common
+// types that don't need the reflection-based walk: strings, byte slices,
+// fixed-width numerics, and time.Time. ok is false if v's type isn't one of
+// these, in which case the caller should fall back to calculateSize.
+func fastSize(v any) (size int64, ok bool) {
+	switch val := v.(type) {
+	case string:
+		return int64(unsafe.Sizeof(val)) + int64(len(val)), true
+	case []byte:
+		return int64(unsafe.Sizeof(val)) + int64(cap(val)), true
+	case int:
+		return int64(unsafe.Sizeof(val)), true
+	case int8:
+		return int64(unsafe.Sizeof(val)), true
+	case int16:
+		return int64(unsafe.Sizeof(val)), true
+	case int32:
+		return int64(unsafe.Sizeof(val)), true
+	case int64:
+		return int64(unsafe.Sizeof(val)), true
+	case uint:
+		return int64(unsafe.Sizeof(val)), true
+	case uint8:
+		return int64(unsafe.Sizeof(val)), true
+	case uint16:
+		return int64(unsafe.Sizeof(val)), true
+	case uint32:
+		return int64(unsafe.Sizeof(val)), true
+	case uint64:
+		return int64(unsafe.Sizeof(val)), true
+	case float32:
+		return int64(unsafe.Sizeof(val)), true
+	case float64:
+		return int64(unsafe.Sizeof(val)), true
+	case bool:
+		return int64(unsafe.Sizeof(val)), true
+	case time.Time:
+		return int64(unsafe.Sizeof(val)), true
+	default:
+		return 0, false
+	}
+}
+
+// NewWithSizer creates a new cache instance with a memory limit *measured in
+// MegaBytes* that uses sizer to compute each entry's byte cost directly,
+// skipping the Sizer check, the fast paths, and the reflection fallback
+// entirely. This is the recommended option for byte-slice or other
+// known-shape caches where the caller already knows the cost of each entry,
+// e.g. len(value).
+func NewWithSizer[Key comparable, Value any](maxMB int64, sizer func(Key, Value) int64) *Cache[Key, Value] {
+	c := New[Key, Value](maxMB)
+	c.sizer = sizer
+	return c
+}
@@ -0,0 +1,136 @@
+package govault
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// EvictReason describes why an entry left the cache, passed to the OnEvict
+// callback configured via NewWithOptions.
+type EvictReason int
+
+const (
+	// ReasonCapacity means the entry was evicted to stay under MaxSize.
+	ReasonCapacity EvictReason = iota
+	// ReasonTTL means the entry was removed because it had expired.
+	ReasonTTL
+	// ReasonManual means the entry was removed by an explicit Delete call.
+	ReasonManual
+)
+
+// defaultJanitorInterval is used when Options.JanitorInterval is zero.
+const defaultJanitorInterval = time.Minute
+
+// Options configures a Cache constructed with NewWithOptions.
+type Options[Key comparable, Value any] struct {
+	// Policy selects the eviction strategy. Defaults to PolicyLRU.
+	Policy Policy
+
+	// OnEvict, if set, is called whenever an entry leaves the cache,
+	// whether by capacity eviction, TTL expiry, or Delete. It runs outside
+	// the cache's internal lock, so it is safe to re-enter the cache from
+	// the callback.
+	OnEvict func(Key, Value, EvictReason)
+
+	// JanitorInterval controls how often the background janitor sweeps
+	// expired entries. Defaults to time.Minute if zero. Only relevant if
+	// SetWithTTL is used.
+	JanitorInterval time.Duration
+}
+
+// NewWithOptions creates a new cache instance with a memory limit *measured
+// in MegaBytes*, configured via Options.
+func NewWithOptions[Key comparable, Value any](maxMB int64, opts Options[Key, Value]) *Cache[Key, Value] {
+	c := NewWithPolicy[Key, Value](maxMB, opts.Policy)
+	c.onEvict = opts.OnEvict
+	c.janitorInterval = opts.JanitorInterval
+	return c
+}
+
+// SetWithTTL is like Set but expires the entry after ttl elapses. A Get
+// against an expired entry is treated as a miss and the entry is removed,
+// reporting ReasonTTL to OnEvict. The first call to SetWithTTL on a Cache
+// also starts a background janitor goroutine that proactively sweeps expired
+// entries; stop it with Close.
+func (c *Cache[Key, Value]) SetWithTTL(key Key, value Value, ttl time.Duration) {
+	c.ensureJanitor()
+	c.setLocked(key, value, time.Now().Add(ttl))
+}
+
+// Close stops the background janitor goroutine started by SetWithTTL, if
+// any. It is safe to call on a Cache that never used TTLs, and safe to call
+// more than once.
+func (c *Cache[Key, Value]) Close() {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	if c.stopJanitor != nil {
+		close(c.stopJanitor)
+		c.stopJanitor = nil
+	}
+}
+
+// ensureJanitor lazily starts the janitor goroutine on first TTL use.
+func (c *Cache[Key, Value]) ensureJanitor() {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	if c.stopJanitor != nil {
+		return
+	}
+
+	interval := c.janitorInterval
+	if interval <= 0 {
+		interval = defaultJanitorInterval
+	}
+
+	c.stopJanitor = make(chan struct{})
+	go c.runJanitor(interval, c.stopJanitor)
+}
+
+// runJanitor periodically sweeps expired entries until stop is closed.
+func (c *Cache[Key, Value]) runJanitor(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.sweepExpired()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// sweepExpired walks the eviction list from the back (oldest) forward,
+// removing any entries whose TTL has elapsed, and reports each to OnEvict
+// with ReasonTTL outside the lock.
+func (c *Cache[Key, Value]) sweepExpired() {
+	c.Mutex.Lock()
+
+	now := time.Now()
+	var evicted []*entry[Key, Value]
+	for elem := c.EvictList.Back(); elem != nil; {
+		prev := elem.Prev()
+
+		ent := elem.Value.(*entry[Key, Value])
+		if !ent.expiresAt.IsZero() && now.After(ent.expiresAt) {
+			evicted = append(evicted, c.removeElementLocked(elem))
+		}
+
+		elem = prev
+	}
+	if len(evicted) > 0 {
+		atomic.AddUint64(&c.statsTTLEvictions, uint64(len(evicted)))
+	}
+
+	onEvict := c.onEvict
+	c.Mutex.Unlock()
+
+	if onEvict != nil {
+		for _, ent := range evicted {
+			onEvict(ent.key, ent.value, ReasonTTL)
+		}
+	}
+}